@@ -0,0 +1,141 @@
+// Package netscape reads cookies from the classic Netscape "cookies.txt"
+// format used by curl, wget and yt-dlp, so files exported by those tools (or
+// by kooky.ExportNetscape) can be fed back through the standard
+// kooky.CookieStore / CookieSeq machinery.
+package netscape
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/browserutils/kooky"
+	"github.com/browserutils/kooky/internal/cookies"
+)
+
+const httpOnlyPrefix = `#HttpOnly_`
+
+// CookieStore reads cookies from a single Netscape cookies.txt file.
+type CookieStore struct {
+	cookies.DefaultCookieStore
+}
+
+var _ kooky.CookieStore = (*CookieStore)(nil)
+
+// New returns a kooky.CookieStore backed by the Netscape cookies.txt file at path.
+func New(path string) kooky.CookieStore {
+	return &CookieStore{
+		DefaultCookieStore: cookies.DefaultCookieStore{
+			BrowserStr:           `netscape`,
+			IsDefaultProfileBool: true,
+			FileNameStr:          path,
+		},
+	}
+}
+
+func (s *CookieStore) Close() error { return nil }
+
+func (s *CookieStore) TraverseCookies(filters ...kooky.Filter) kooky.CookieSeq {
+	return func(yield func(*kooky.Cookie, error) bool) {
+		file, err := os.Open(s.FilePath())
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if line == `` {
+				continue
+			}
+			if strings.HasPrefix(line, `#`) && !strings.HasPrefix(line, httpOnlyPrefix) {
+				continue
+			}
+
+			cookie, err := parseLine(line, s)
+			if err != nil {
+				if !yield(nil, err) {
+					return
+				}
+				continue
+			}
+			if !kooky.FilterCookie(context.Background(), cookie, filters...) {
+				continue
+			}
+			if !yield(cookie, nil) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+func parseLine(line string, store *CookieStore) (*kooky.Cookie, error) {
+	httpOnly := strings.HasPrefix(line, httpOnlyPrefix)
+	line = strings.TrimPrefix(line, httpOnlyPrefix)
+
+	fields := strings.Split(line, "\t")
+	if len(fields) != 7 {
+		return nil, fmt.Errorf(`netscape: malformed line (want 7 tab-separated fields, got %d): %q`, len(fields), line)
+	}
+	domain, includeSubdomains, path, secureStr, expirationStr, name, value := fields[0], fields[1], fields[2], fields[3], fields[4], fields[5], fields[6]
+	// the leading dot on Domain is the sole carrier of "include subdomains"
+	// once the cookie becomes a kooky.Cookie, so normalize it here rather
+	// than silently dropping the flag for files that set it without a
+	// leading dot on the domain.
+	if includeSubdomains == `TRUE` && !strings.HasPrefix(domain, `.`) {
+		domain = `.` + domain
+	}
+
+	expirationUnix, err := strconv.ParseInt(expirationStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf(`netscape: invalid expiration %q: %w`, expirationStr, err)
+	}
+	var expires time.Time
+	if expirationUnix != 0 {
+		expires = time.Unix(expirationUnix, 0)
+	}
+
+	cookie := &kooky.Cookie{Creation: time.Now(), Browser: store}
+	cookie.Domain = domain
+	cookie.Path = path
+	cookie.Secure = secureStr == `TRUE`
+	cookie.HttpOnly = httpOnly
+	cookie.Expires = expires
+	cookie.Name = name
+	cookie.Value = value
+
+	return cookie, nil
+}
+
+// Finder discovers Netscape cookies.txt files. Unlike browser profile
+// directories, Netscape cookie files have no canonical OS location, so
+// discovery is limited to the path named by the KOOKY_NETSCAPE_COOKIES_FILE
+// environment variable; callers that already know the path should use New
+// directly instead.
+type Finder struct{}
+
+var _ kooky.CookieStoreFinder = (*Finder)(nil)
+
+func init() {
+	kooky.RegisterFinder(`netscape`, &Finder{})
+}
+
+func (f *Finder) FindCookieStores() ([]kooky.CookieStore, error) {
+	path := os.Getenv(`KOOKY_NETSCAPE_COOKIES_FILE`)
+	if path == `` {
+		return nil, nil
+	}
+	if _, err := os.Stat(path); err != nil {
+		return nil, nil
+	}
+	return []kooky.CookieStore{New(path)}, nil
+}
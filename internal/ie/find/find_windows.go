@@ -3,8 +3,10 @@
 package find
 
 import (
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/browserutils/kooky"
@@ -102,5 +104,139 @@ func (f *IEFinder) FindCookieStores() ([]kooky.CookieStore, error) {
 		},
 	)
 
+	cookiesFiles = append(cookiesFiles, f.findAppContainerCookieStores(locApp)...)
+	cookiesFiles = append(cookiesFiles, f.findEnterpriseModeCookieStores(appData)...)
+
 	return cookiesFiles, nil
 }
+
+// findAppContainerCookieStores walks the per-package AppContainer cookie
+// jars used by modern IE/Edge deployments under
+// %LOCALAPPDATA%\Packages\*\AC\...\Cookies, including Edge Legacy's
+// Microsoft.MicrosoftEdge_8wekyb3d8bbwe package, and the containers'
+// INetCookies folders. A recursive walk (rather than a fixed-depth
+// glob) is used because real AppContainer layouts vary in depth, e.g.
+// AC\#!001\MicrosoftEdge\Cookies; the walk visits the whole tree but only
+// keeps index.dat files whose parent folder is "Cookies" or "INetCookies",
+// so INetCache's content-cache index.dat files (which aren't cookie jars)
+// are never handed to IECacheCookieStore. Each store is
+// tagged with the owning package family name via both ProfileStr (surfaced
+// as kooky.Cookie.Browser.Profile()) and withContainer (surfaced as
+// kooky.Cookie.Container), so it's meaningful regardless of which one a
+// caller reads. Because the walk covers the whole Packages tree,
+// newly-installed AppContainer apps are picked up automatically without
+// updating a fixed path list.
+func (f *IEFinder) findAppContainerCookieStores(locApp string) []kooky.CookieStore {
+	if locApp == `` {
+		return nil
+	}
+	packagesDir := filepath.Join(locApp, `Packages`)
+
+	var stores []kooky.CookieStore
+	_ = filepath.WalkDir(packagesDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		if d.Name() != `index.dat` || !isCookieJarDir(filepath.Base(filepath.Dir(path))) {
+			return nil
+		}
+		if !strings.Contains(path, string(filepath.Separator)+`AC`+string(filepath.Separator)) {
+			return nil
+		}
+		container := appContainerName(packagesDir, path)
+		stores = append(stores, withContainer(&cookies.CookieJar{
+			CookieStore: &ie.CookieStore{
+				CookieStore: &ie.IECacheCookieStore{
+					DefaultCookieStore: cookies.DefaultCookieStore{
+						BrowserStr:           f.Browser,
+						ProfileStr:           container,
+						IsDefaultProfileBool: false,
+						FileNameStr:          path,
+					},
+				},
+			},
+		}, container))
+		return nil
+	})
+	return stores
+}
+
+// isCookieJarDir reports whether dir is a folder known to hold cookie jars
+// (as opposed to INetCache's general-purpose content cache, which also
+// stores index.dat files under AppContainer paths).
+func isCookieJarDir(dir string) bool {
+	return dir == `Cookies` || dir == `INetCookies`
+}
+
+// findEnterpriseModeCookieStores globs the Enterprise Mode site-list "Low"
+// and "MSIE" cache subdirectories, tagging each store with the partition
+// directory name via both ProfileStr (kooky.Cookie.Browser.Profile()) and
+// withContainer (kooky.Cookie.Container).
+func (f *IEFinder) findEnterpriseModeCookieStores(appData string) []kooky.CookieStore {
+	if appData == `` {
+		return nil
+	}
+	var patterns []string
+	patterns = append(patterns, filepath.Join(appData, `Microsoft`, `Windows`, `INetCache`, `IE`, `Low`, `*`, `index.dat`))
+	patterns = append(patterns, filepath.Join(appData, `Microsoft`, `Windows`, `INetCache`, `IE`, `MSIE`, `*`, `index.dat`))
+
+	var stores []kooky.CookieStore
+	for _, pattern := range patterns {
+		matches, _ := filepath.Glob(pattern)
+		for _, path := range matches {
+			partition := filepath.Base(filepath.Dir(path))
+			stores = append(stores, withContainer(&cookies.CookieJar{
+				CookieStore: &ie.CookieStore{
+					CookieStore: &ie.IECacheCookieStore{
+						DefaultCookieStore: cookies.DefaultCookieStore{
+							BrowserStr:           f.Browser,
+							ProfileStr:           partition,
+							IsDefaultProfileBool: false,
+							FileNameStr:          path,
+						},
+					},
+				},
+			}, partition))
+		}
+	}
+	return stores
+}
+
+// appContainerName extracts the package family name (e.g.
+// Microsoft.MicrosoftEdge_8wekyb3d8bbwe) from a path under packagesDir.
+func appContainerName(packagesDir, path string) string {
+	rel, err := filepath.Rel(packagesDir, path)
+	if err != nil {
+		return ``
+	}
+	parts := strings.Split(rel, string(filepath.Separator))
+	if len(parts) == 0 {
+		return ``
+	}
+	return parts[0]
+}
+
+// containerCookieStore tags every cookie yielded by the wrapped store with
+// container, since DefaultCookieStore itself has no Container field for
+// kooky.Cookie.Container to draw from.
+type containerCookieStore struct {
+	kooky.CookieStore
+	container string
+}
+
+func withContainer(store kooky.CookieStore, container string) kooky.CookieStore {
+	return &containerCookieStore{CookieStore: store, container: container}
+}
+
+func (s *containerCookieStore) TraverseCookies(filters ...kooky.Filter) kooky.CookieSeq {
+	return func(yield func(*kooky.Cookie, error) bool) {
+		for cookie, err := range s.CookieStore.TraverseCookies(filters...) {
+			if cookie != nil {
+				cookie.Container = s.container
+			}
+			if !yield(cookie, err) {
+				return
+			}
+		}
+	}
+}
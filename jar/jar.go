@@ -0,0 +1,187 @@
+// Package jar adapts kooky-sourced cookies into a net/http.CookieJar, so an
+// http.Client can transparently attach browser-extracted cookies to outgoing
+// requests:
+//
+//	client := &http.Client{}
+//	client.Jar, _ = jar.NewCookieJar(ctx, jar.WithFilters(kooky.Domain(`example.com`)))
+package jar
+
+import (
+	"context"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/browserutils/kooky"
+)
+
+// Jar is a read-mostly net/http.CookieJar backed by cookies discovered
+// through kooky. By default SetCookies is a no-op; use WithSessionOverlay to
+// layer an in-memory cookiejar.Jar on top for cookies set during the session.
+type Jar struct {
+	scan    func(context.Context) kooky.CookieSeq
+	filters []kooky.Filter
+	ttl     time.Duration
+	overlay *cookiejar.Jar
+
+	mu        sync.RWMutex
+	cookies   kooky.Cookies
+	scannedAt time.Time
+}
+
+var _ http.CookieJar = (*Jar)(nil)
+
+// Option configures a Jar built by NewCookieJar or New.
+type Option func(*Jar)
+
+// WithFilters restricts the Jar to cookies matching filters.
+func WithFilters(filters ...kooky.Filter) Option {
+	return func(j *Jar) { j.filters = filters }
+}
+
+// WithRescanTTL makes the Jar re-run cookie discovery at most once per ttl,
+// so cookies created by a browser after the Jar was built become visible
+// without restarting the process. Without this option the Jar only scans
+// once, at construction time.
+func WithRescanTTL(ttl time.Duration) Option {
+	return func(j *Jar) { j.ttl = ttl }
+}
+
+// WithSessionOverlay forwards cookies a server sets during the session (via
+// SetCookies, normally a no-op) to an in-memory cookiejar.Jar layered on top
+// of the read-only browser cookies.
+func WithSessionOverlay() Option {
+	return func(j *Jar) { j.overlay, _ = cookiejar.New(nil) }
+}
+
+// NewCookieJar wraps the cookies visible to kooky.TraverseCookies across all
+// registered CookieStore finders.
+func NewCookieJar(ctx context.Context, opts ...Option) (*Jar, error) {
+	j := &Jar{}
+	for _, opt := range opts {
+		opt(j)
+	}
+	j.scan = func(ctx context.Context) kooky.CookieSeq {
+		return kooky.TraverseCookies(ctx, j.filters...)
+	}
+	if err := j.rescan(ctx); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// New wraps seq directly, e.g. a single kooky.CookieStore's TraverseCookies
+// result, or several merged with kooky.MergeCookieSeqs.
+func New(ctx context.Context, seq kooky.CookieSeq, opts ...Option) (*Jar, error) {
+	j := &Jar{scan: func(context.Context) kooky.CookieSeq { return seq }}
+	for _, opt := range opts {
+		opt(j)
+	}
+	if err := j.rescan(ctx); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *Jar) rescan(ctx context.Context) error {
+	cookies, err := j.scan(ctx).ReadAllCookies(ctx)
+	if err != nil {
+		return err
+	}
+	j.mu.Lock()
+	j.cookies = cookies
+	j.scannedAt = time.Now()
+	j.mu.Unlock()
+	return nil
+}
+
+func (j *Jar) maybeRescan() {
+	if j.ttl <= 0 {
+		return
+	}
+	j.mu.RLock()
+	stale := time.Since(j.scannedAt) > j.ttl
+	j.mu.RUnlock()
+	if !stale {
+		return
+	}
+	_ = j.rescan(context.Background())
+}
+
+// Cookies implements net/http.CookieJar, returning the cookies that apply to
+// u per RFC 6265 domain/path matching, Secure and expiration. SameSite is
+// intentionally not consulted: that attribute restricts whether a cookie is
+// attached based on the site that *initiated* the request, a distinction
+// http.CookieJar's Cookies(u) has no way to express (the same reason net/http's
+// own cookiejar.Jar ignores it), so every cookie is treated as same-site here.
+func (j *Jar) Cookies(u *url.URL) []*http.Cookie {
+	j.maybeRescan()
+
+	j.mu.RLock()
+	cookies := j.cookies
+	j.mu.RUnlock()
+
+	now := time.Now()
+	var out []*http.Cookie
+	for _, cookie := range cookies {
+		if cookie == nil {
+			continue
+		}
+		if !domainMatch(cookie.Domain, u.Hostname()) {
+			continue
+		}
+		if !pathMatch(cookie.Path, u.Path) {
+			continue
+		}
+		if cookie.Secure && u.Scheme != `https` {
+			continue
+		}
+		if !cookie.Expires.IsZero() && cookie.Expires.Before(now) {
+			continue
+		}
+		c := cookie.Cookie
+		out = append(out, &c)
+	}
+
+	if j.overlay != nil {
+		out = append(out, j.overlay.Cookies(u)...)
+	}
+
+	return out
+}
+
+// SetCookies implements net/http.CookieJar. It is a no-op unless
+// WithSessionOverlay was passed to NewCookieJar/New.
+func (j *Jar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	if j.overlay != nil {
+		j.overlay.SetCookies(u, cookies)
+	}
+}
+
+// domainMatch applies RFC 6265 §5.1.3: a leading dot on cookieDomain marks a
+// domain cookie, which also matches subdomains; without it, the cookie is
+// host-only and must match host exactly.
+func domainMatch(cookieDomain, host string) bool {
+	host = strings.ToLower(host)
+	if strings.HasPrefix(cookieDomain, `.`) {
+		domain := strings.ToLower(strings.TrimPrefix(cookieDomain, `.`))
+		return host == domain || strings.HasSuffix(host, `.`+domain)
+	}
+	return host == strings.ToLower(cookieDomain)
+}
+
+func pathMatch(cookiePath, requestPath string) bool {
+	if cookiePath == `` || cookiePath == `/` {
+		return true
+	}
+	if requestPath == cookiePath {
+		return true
+	}
+	if !strings.HasPrefix(requestPath, cookiePath) {
+		return false
+	}
+	return cookiePath[len(cookiePath)-1] == '/' || requestPath[len(cookiePath)] == '/'
+}
@@ -0,0 +1,194 @@
+package kooky
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// jsonCookie is the stable wire format used by Cookie's JSON (de)serializers
+// and by EncodeJSONL/DecodeCookiesJSONL.
+type jsonCookie struct {
+	Name       string `json:"name"`
+	Value      string `json:"value"`
+	Domain     string `json:"domain"`
+	Path       string `json:"path"`
+	Expires    string `json:"expires,omitempty"`
+	Creation   string `json:"creation,omitempty"`
+	Secure     bool   `json:"secure"`
+	HTTPOnly   bool   `json:"httpOnly"`
+	SameSite   string `json:"sameSite,omitempty"`
+	Browser    string `json:"browser,omitempty"`
+	Profile    string `json:"profile,omitempty"`
+	Container  string `json:"container,omitempty"`
+	SourceFile string `json:"source_file,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, encoding c in the stable jsonCookie
+// wire format.
+func (c *Cookie) MarshalJSON() ([]byte, error) {
+	if c == nil {
+		return []byte(`null`), nil
+	}
+	jc := jsonCookie{
+		Name:      c.Name,
+		Value:     c.Value,
+		Domain:    c.Domain,
+		Path:      c.Path,
+		Secure:    c.Secure,
+		HTTPOnly:  c.HttpOnly,
+		SameSite:  sameSiteString(c.SameSite),
+		Container: c.Container,
+	}
+	if !c.Expires.IsZero() {
+		jc.Expires = c.Expires.UTC().Format(time.RFC3339)
+	}
+	if !c.Creation.IsZero() {
+		jc.Creation = c.Creation.UTC().Format(time.RFC3339)
+	}
+	if c.Browser != nil {
+		jc.Browser = c.Browser.Browser()
+		jc.Profile = c.Browser.Profile()
+		jc.SourceFile = c.Browser.FilePath()
+	}
+	return json.Marshal(jc)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding the jsonCookie wire
+// format written by MarshalJSON.
+func (c *Cookie) UnmarshalJSON(data []byte) error {
+	var jc jsonCookie
+	if err := json.Unmarshal(data, &jc); err != nil {
+		return err
+	}
+
+	c.Name = jc.Name
+	c.Value = jc.Value
+	c.Domain = jc.Domain
+	c.Path = jc.Path
+	c.Secure = jc.Secure
+	c.HttpOnly = jc.HTTPOnly
+	c.SameSite = parseSameSite(jc.SameSite)
+	c.Container = jc.Container
+
+	if jc.Expires != `` {
+		t, err := time.Parse(time.RFC3339, jc.Expires)
+		if err != nil {
+			return fmt.Errorf(`kooky: invalid expires %q: %w`, jc.Expires, err)
+		}
+		c.Expires = t
+	}
+	if jc.Creation != `` {
+		t, err := time.Parse(time.RFC3339, jc.Creation)
+		if err != nil {
+			return fmt.Errorf(`kooky: invalid creation %q: %w`, jc.Creation, err)
+		}
+		c.Creation = t
+	}
+	if jc.Browser != `` || jc.Profile != `` || jc.SourceFile != `` {
+		c.Browser = jsonBrowserInfo{browser: jc.Browser, profile: jc.Profile, filePath: jc.SourceFile}
+	}
+
+	return nil
+}
+
+// jsonBrowserInfo reconstructs a BrowserInfo for cookies decoded from JSON,
+// since the original CookieStore they came from is not available.
+type jsonBrowserInfo struct {
+	browser  string
+	profile  string
+	filePath string
+}
+
+func (b jsonBrowserInfo) Browser() string        { return b.browser }
+func (b jsonBrowserInfo) Profile() string        { return b.profile }
+func (b jsonBrowserInfo) IsDefaultProfile() bool { return false }
+func (b jsonBrowserInfo) FilePath() string       { return b.filePath }
+
+func sameSiteString(s http.SameSite) string {
+	switch s {
+	case http.SameSiteLaxMode:
+		return `Lax`
+	case http.SameSiteStrictMode:
+		return `Strict`
+	case http.SameSiteNoneMode:
+		return `None`
+	default:
+		return ``
+	}
+}
+
+func parseSameSite(s string) http.SameSite {
+	switch s {
+	case `Lax`:
+		return http.SameSiteLaxMode
+	case `Strict`:
+		return http.SameSiteStrictMode
+	case `None`:
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteDefaultMode
+	}
+}
+
+// MarshalJSON implements json.Marshaler, encoding c as a JSON array of
+// jsonCookie objects. A nil Cookies marshals as [] rather than null.
+func (c Cookies) MarshalJSON() ([]byte, error) {
+	if c == nil {
+		return []byte(`[]`), nil
+	}
+	return json.Marshal([]*Cookie(c))
+}
+
+// UnmarshalJSON implements json.Unmarshaler for a JSON array written by
+// MarshalJSON.
+func (c *Cookies) UnmarshalJSON(data []byte) error {
+	var raw []*Cookie
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*c = raw
+	return nil
+}
+
+// EncodeJSONL writes s to w as newline-delimited JSON, one jsonCookie object
+// per line, so cookies can be piped between processes or persisted as a
+// snapshot for tools like jq or DuckDB.
+func (s CookieSeq) EncodeJSONL(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	var errs []error
+	for cookie, err := range s {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if cookie == nil {
+			continue
+		}
+		if err := enc.Encode(cookie); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DecodeCookiesJSONL reads newline-delimited JSON written by
+// CookieSeq.EncodeJSONL back into a CookieSeq.
+func DecodeCookiesJSONL(r io.Reader) CookieSeq {
+	return func(yield func(*Cookie, error) bool) {
+		dec := json.NewDecoder(r)
+		for dec.More() {
+			var cookie Cookie
+			if err := dec.Decode(&cookie); err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(&cookie, nil) {
+				return
+			}
+		}
+	}
+}
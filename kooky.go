@@ -42,14 +42,53 @@ func AllCookies(filters ...Filter) Cookies {
 // for-rangeable cookie retriever
 type CookieSeq iter.Seq2[*Cookie, error]
 
+// TraverseCookiesOptions configures the worker pool used by
+// TraverseCookiesWith. A zero value is equivalent to the defaults used by
+// TraverseCookies.
+type TraverseCookiesOptions struct {
+	// StoreConcurrency bounds how many cookie stores are traversed at the
+	// same time. Zero means runtime.NumCPU().
+	StoreConcurrency int
+	// ConsumerConcurrency bounds how many goroutines pull cookies off the
+	// internal channel and yield them to the caller. Zero means
+	// runtime.NumCPU().
+	ConsumerConcurrency int
+	// ChannelBuffer sizes the channel connecting store producers to
+	// consumers, smoothing bursty producers. Zero means runtime.NumCPU().
+	ChannelBuffer int
+}
+
+func (o TraverseCookiesOptions) withDefaults() TraverseCookiesOptions {
+	if o.StoreConcurrency <= 0 {
+		o.StoreConcurrency = runtime.NumCPU()
+	}
+	if o.ConsumerConcurrency <= 0 {
+		o.ConsumerConcurrency = runtime.NumCPU()
+	}
+	if o.ChannelBuffer <= 0 {
+		o.ChannelBuffer = runtime.NumCPU()
+	}
+	return o
+}
+
 func TraverseCookies(ctx context.Context, filters ...Filter) CookieSeq {
+	return TraverseCookiesWith(ctx, TraverseCookiesOptions{}, filters...)
+}
+
+// TraverseCookiesWith is TraverseCookies with a configurable worker pool:
+// opts.StoreConcurrency caps how many cookie stores are opened at once and
+// opts.ChannelBuffer sizes the channel between store producers and
+// consumers, so machines with many browser profiles don't flood goroutines
+// or block producers on a tiny channel.
+func TraverseCookiesWith(ctx context.Context, opts TraverseCookiesOptions, filters ...Filter) CookieSeq {
+	opts = opts.withDefaults()
 	return func(yield func(*Cookie, error) bool) {
 		ctx, cancel := context.WithCancel(ctx)
 		type ce struct {
 			c *Cookie
 			e error
 		}
-		cookieChan := make(chan ce, 1)
+		cookieChan := make(chan ce, opts.ChannelBuffer)
 
 		var wgTot sync.WaitGroup
 		defer wgTot.Wait()
@@ -60,32 +99,37 @@ func TraverseCookies(ctx context.Context, filters ...Filter) CookieSeq {
 			var wgTrav sync.WaitGroup
 			defer func() {
 				wgTrav.Wait()
-				cancel()
+				// close without cancelling: consumers select on both
+				// ctx.Done() and cookieChan, so a cancel here would let
+				// Go's random select drop buffered cookies instead of
+				// draining them. The consumer that receives from the
+				// closed, already drained channel cancels instead.
 				close(cookieChan)
 			}()
+			storeSem := make(chan struct{}, opts.StoreConcurrency)
 			for cookieStore, _ := range TraverseCookieStores(ctx) {
 				select {
 				case <-ctx.Done():
 					return
-				default:
+				case storeSem <- struct{}{}:
 				}
 				wgTrav.Add(1)
 				go func(cookieStore CookieStore) {
 					defer wgTrav.Done()
+					defer func() { <-storeSem }()
 					for cookie, err := range cookieStore.TraverseCookies(filters...) {
 						select {
 						case <-ctx.Done():
 							return
-						default:
+						case cookieChan <- ce{c: cookie, e: err}:
 						}
-						cookieChan <- ce{c: cookie, e: err}
 					}
 				}(cookieStore)
 			}
 		}()
 
-		wgTot.Add(runtime.NumCPU())
-		for range runtime.NumCPU() {
+		wgTot.Add(opts.ConsumerConcurrency)
+		for range opts.ConsumerConcurrency {
 			go func(yield func(*Cookie, error) bool) {
 				defer wgTot.Done()
 				for {
@@ -0,0 +1,92 @@
+package kooky
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	netscapeHeader = "# Netscape HTTP Cookie File\n"
+	httpOnlyPrefix = `#HttpOnly_`
+)
+
+// WriteNetscape writes c to w in the classic Netscape "cookies.txt" format
+// used by curl, wget and yt-dlp, the symmetric counterpart to the reader in
+// github.com/browserutils/kooky/browser/netscape.
+func (c Cookies) WriteNetscape(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(netscapeHeader); err != nil {
+		return err
+	}
+	for _, cookie := range c {
+		if cookie == nil {
+			continue
+		}
+		if err := writeNetscapeCookie(bw, cookie); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// ExportNetscape streams cookies matched by filters straight from
+// TraverseCookies into w in the Netscape cookies.txt format, without
+// collecting them in memory first.
+func ExportNetscape(ctx context.Context, w io.Writer, filters ...Filter) error {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(netscapeHeader); err != nil {
+		return err
+	}
+
+	var errs []error
+	for cookie, err := range TraverseCookies(ctx, filters...) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if cookie == nil {
+			continue
+		}
+		if err := writeNetscapeCookie(bw, cookie); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func writeNetscapeCookie(w *bufio.Writer, cookie *Cookie) error {
+	if strings.ContainsAny(cookie.Name, "\t\n") || strings.ContainsAny(cookie.Value, "\t\n") {
+		return fmt.Errorf(`kooky: netscape: cookie %q has a tab or newline in its name or value`, cookie.Name)
+	}
+
+	domain := cookie.Domain
+	includeSubdomains := `FALSE`
+	if strings.HasPrefix(domain, `.`) {
+		includeSubdomains = `TRUE`
+	}
+
+	var expiration int64
+	if !cookie.Expires.IsZero() {
+		expiration = cookie.Expires.Unix()
+	}
+
+	secure := `FALSE`
+	if cookie.Secure {
+		secure = `TRUE`
+	}
+
+	if cookie.HttpOnly {
+		domain = httpOnlyPrefix + domain
+	}
+
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+		domain, includeSubdomains, cookie.Path, secure, expiration, cookie.Name, cookie.Value)
+	return err
+}
@@ -0,0 +1,59 @@
+package kooky
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrParseCookie is returned by ParseSetCookie and ParseCookieHeader when the
+// input cannot be parsed as a Set-Cookie or Cookie header value.
+var ErrParseCookie = errors.New(`kooky: parse cookie`)
+
+// ParseSetCookie parses a raw Set-Cookie header line - as seen in an HTTP
+// response, a HAR file or a mitmproxy dump - into a kooky.Cookie, so cookies
+// captured outside of a browser profile can be mixed into the same
+// CookieSeq pipeline as browser-extracted ones. Parsing is done by net/http:
+// a malformed name=value pair fails with ErrParseCookie, while malformed
+// attributes are dropped the same way net/http drops them.
+func ParseSetCookie(line string, browser BrowserInfo) (*Cookie, error) {
+	header := http.Header{}
+	header.Add(`Set-Cookie`, line)
+	resp := http.Response{Header: header}
+	cookies := resp.Cookies()
+	if len(cookies) == 0 {
+		return nil, fmt.Errorf(`%w: %q`, ErrParseCookie, line)
+	}
+	return &Cookie{
+		Cookie:   *cookies[0],
+		Creation: time.Now(),
+		Browser:  browser,
+	}, nil
+}
+
+// ParseCookieHeader parses a raw Cookie header line (name=value; name2=value2)
+// as sent in an HTTP request into Cookies. Unlike a Set-Cookie header, a
+// Cookie header carries no attributes beyond name/value, so only Name,
+// Value (and Quoted), Creation and Browser are populated on the result.
+func ParseCookieHeader(line string, browser BrowserInfo) (Cookies, error) {
+	header := http.Header{}
+	header.Add(`Cookie`, line)
+	req := http.Request{Header: header}
+	parsed := req.Cookies()
+	if len(parsed) == 0 && strings.TrimSpace(line) != `` {
+		return nil, fmt.Errorf(`%w: %q`, ErrParseCookie, line)
+	}
+
+	now := time.Now()
+	cookies := make(Cookies, 0, len(parsed))
+	for _, c := range parsed {
+		cookies = append(cookies, &Cookie{
+			Cookie:   *c,
+			Creation: now,
+			Browser:  browser,
+		})
+	}
+	return cookies, nil
+}